@@ -0,0 +1,70 @@
+package frequency
+
+import (
+	"bytes"
+	"compress/flate"
+)
+
+// maxDictionarySize is flate's sliding window: a larger dictionary is accepted, but only its trailing
+// maxDictionarySize bytes are ever referenced during compression.
+const maxDictionarySize = 32 << 10
+
+// CompressionScorer classifies text by cross-compression: a candidate that compresses well once primed with a
+// reference corpus as a dictionary is judged similar to that corpus.  Unlike Analyzer's byte histogram, this is
+// order-sensitive, so it picks up structure - word boundaries, common phrases - that a 256-bin histogram can't.
+type CompressionScorer struct {
+	dictionary []byte
+}
+
+// NewCompressionScorer - build a CompressionScorer against reference.  reference is copied into a preset flate
+// dictionary rather than recompressed on every Score call, so repeated calls are cheap and later mutation of
+// the caller's reference slice can't corrupt the dictionary.
+func NewCompressionScorer(reference []byte) *CompressionScorer {
+	window := reference
+	if len(window) > maxDictionarySize {
+		window = window[len(window)-maxDictionarySize:]
+	}
+
+	return &CompressionScorer{dictionary: append([]byte(nil), window...)}
+}
+
+// Score - score contents against the reference corpus.  Return a value in the range of 0 - 1, comparable to
+// Analyzer.Score, where a higher value means contents is a better match for the reference.
+//
+// Internally this is (C(reference+contents) - C(reference)) / len(contents): compressing contents with the
+// reference preset as a flate dictionary already yields an encoding of contents alone, given the reference as
+// known context, which approximates C(reference+contents) - C(reference) without having to recompress the
+// reference on every call. That ratio is the average number of compressed bytes contents adds per input byte
+// once primed with the reference - lower is a better match - so it is inverted into the same "higher is more
+// similar" range the rest of this package uses.
+func (c *CompressionScorer) Score(contents []byte) float64 {
+	if len(contents) == 0 {
+		return 0
+	}
+
+	ratio := float64(compressedSize(contents, c.dictionary)) / float64(len(contents))
+
+	return 1 / (1 + ratio)
+}
+
+// compressedSize returns the length of data after compress/flate, using dict as a preset dictionary if given.
+func compressedSize(data []byte, dict []byte) int64 {
+	var buf bytes.Buffer
+
+	var w *flate.Writer
+	var err error
+	if dict != nil {
+		w, err = flate.NewWriterDict(&buf, flate.BestCompression, dict)
+	} else {
+		w, err = flate.NewWriter(&buf, flate.BestCompression)
+	}
+	if err != nil {
+		// Only returned for an invalid compression level, and BestCompression is always valid.
+		panic(err)
+	}
+
+	w.Write(data)
+	w.Close()
+
+	return int64(buf.Len())
+}
@@ -0,0 +1,46 @@
+package frequency
+
+import "testing"
+
+func TestCompressionScorerRanksMatchingReferenceHigher(t *testing.T) {
+	reference := []byte(`the quick brown fox jumps over the lazy dog repeatedly while the lazy dog watches
+the quick brown fox jumps over the lazy dog repeatedly while the lazy dog watches
+the quick brown fox jumps over the lazy dog repeatedly while the lazy dog watches`)
+
+	s := NewCompressionScorer(reference)
+
+	matching := s.Score([]byte("the quick brown fox jumps over the lazy dog repeatedly"))
+	mismatched := s.Score([]byte("xqzvwky plmjh fdswabcdefghijklmnopqrstuvwxyz0123456789"))
+
+	if matching <= mismatched {
+		t.Fatalf("expected matching text to score higher than mismatched text: matching=%v mismatched=%v", matching, mismatched)
+	}
+}
+
+func TestCompressionScorerEmptyInput(t *testing.T) {
+	s := NewCompressionScorer([]byte("the quick brown fox"))
+
+	if score := s.Score(nil); score != 0 {
+		t.Fatalf("expected Score(nil) to be 0, got %v", score)
+	}
+	if score := s.Score([]byte{}); score != 0 {
+		t.Fatalf("expected Score of an empty slice to be 0, got %v", score)
+	}
+}
+
+func TestCompressionScorerCopiesReference(t *testing.T) {
+	reference := []byte("the quick brown fox jumps over the lazy dog")
+	s := NewCompressionScorer(reference)
+
+	before := s.Score([]byte("the quick brown fox"))
+
+	// Mutating the caller's slice after construction must not affect later scoring.
+	for i := range reference {
+		reference[i] = 'x'
+	}
+
+	after := s.Score([]byte("the quick brown fox"))
+	if before != after {
+		t.Fatalf("expected mutating the caller's reference slice not to affect the dictionary: before=%v after=%v", before, after)
+	}
+}
@@ -0,0 +1,106 @@
+package frequency
+
+import (
+	"sort"
+	"sync"
+)
+
+// LanguageMatch is the score of a single named analyzer against some contents, as returned by Detector.Detect.
+type LanguageMatch struct {
+	Name  string
+	Score float64
+}
+
+// Scorer is implemented by anything that can score contents against a reference profile, returning a value
+// where higher means more similar.  *Analyzer and *CompressionScorer already implement Scorer as-is; a
+// *RuneAnalyzer's Score takes an optional ScoreMethod, so register it via ScorerFunc under a chosen method.
+type Scorer interface {
+	Score(contents []byte) float64
+}
+
+// ScorerFunc adapts a plain func to Scorer, e.g. to register a *RuneAnalyzer under a fixed ScoreMethod:
+//
+//	d.Register("spanish", frequency.ScorerFunc(func(contents []byte) float64 {
+//		return spanishRuneAnalyzer.Score(contents, frequency.CosineSimilarity)
+//	}))
+type ScorerFunc func(contents []byte) float64
+
+// Score - implements Scorer by calling f.
+func (f ScorerFunc) Score(contents []byte) float64 {
+	return f(contents)
+}
+
+// Detector holds a named set of Scorers and scores contents against all of them at once, e.g. for language
+// identification rather than a single analyzer's "how English-like is this blob".
+type Detector struct {
+	mu            sync.RWMutex
+	scorers       map[string]Scorer
+	minConfidence float64
+}
+
+// NewDetector - create an empty Detector.  Use Register to populate it with scorers.
+func NewDetector() *Detector {
+	return &Detector{scorers: make(map[string]Scorer)}
+}
+
+// Register - register a Scorer under name, overwriting any Scorer previously registered under the same name.
+func (d *Detector) Register(name string, s Scorer) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.scorers[name] = s
+}
+
+// SetMinConfidence - set the minimum score an analyzer must reach to be included in Detect's results.
+func (d *Detector) SetMinConfidence(threshold float64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.minConfidence = threshold
+}
+
+// Detect - score contents against every registered Scorer, returning the matches that meet the minimum
+// confidence threshold sorted from highest to lowest score.
+func (d *Detector) Detect(contents []byte) []LanguageMatch {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	matches := make([]LanguageMatch, 0, len(d.scorers))
+	for name, s := range d.scorers {
+		score := s.Score(contents)
+		if score < d.minConfidence {
+			continue
+		}
+		matches = append(matches, LanguageMatch{Name: name, Score: score})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	return matches
+}
+
+// BestMatch - the single highest-scoring match for contents.  The second return value is false if no registered
+// analyzer met the minimum confidence threshold.
+func (d *Detector) BestMatch(contents []byte) (LanguageMatch, bool) {
+	matches := d.Detect(contents)
+	if len(matches) == 0 {
+		return LanguageMatch{}, false
+	}
+
+	return matches[0], true
+}
+
+// DefaultDetector is a Detector pre-populated with every built-in analyzer shipped by this package.
+var DefaultDetector = NewDetector()
+
+func init() {
+	DefaultDetector.Register("english", EnglishAnalyzer)
+	DefaultDetector.Register("french", FrenchAnalyzer)
+	DefaultDetector.Register("german", GermanAnalyzer)
+	DefaultDetector.Register("spanish", SpanishAnalyzer)
+	DefaultDetector.Register("italian", ItalianAnalyzer)
+	DefaultDetector.Register("portuguese", PortugueseAnalyzer)
+	DefaultDetector.Register("dutch", DutchAnalyzer)
+}
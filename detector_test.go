@@ -0,0 +1,124 @@
+package frequency
+
+import "testing"
+
+func TestDetectorDetectOrdersByScoreDescending(t *testing.T) {
+	a := NewAnalyzer()
+	a.Feed([]byte("aaaaaaaaaa"))
+
+	b := NewAnalyzer()
+	b.Feed([]byte("aaaaaaaaab"))
+
+	c := NewAnalyzer()
+	c.Feed([]byte("zzzzzzzzzz"))
+
+	d := NewDetector()
+	d.Register("a", a)
+	d.Register("b", b)
+	d.Register("c", c)
+
+	matches := d.Detect([]byte("aaaaaaaaaa"))
+	if len(matches) != 3 {
+		t.Fatalf("expected 3 matches, got %d", len(matches))
+	}
+	for i := 1; i < len(matches); i++ {
+		if matches[i-1].Score < matches[i].Score {
+			t.Fatalf("matches not sorted descending: %+v", matches)
+		}
+	}
+	if matches[0].Name != "a" {
+		t.Fatalf("expected the exact match %q to rank first, got %q", "a", matches[0].Name)
+	}
+}
+
+func TestDetectorSetMinConfidenceFiltersLowScores(t *testing.T) {
+	a := NewAnalyzer()
+	a.Feed([]byte("aaaaaaaaaa"))
+
+	z := NewAnalyzer()
+	z.Feed([]byte("zzzzzzzzzz"))
+
+	d := NewDetector()
+	d.Register("a", a)
+	d.Register("z", z)
+
+	all := d.Detect([]byte("aaaaaaaaaa"))
+	if len(all) != 2 {
+		t.Fatalf("expected 2 matches with no threshold, got %d", len(all))
+	}
+
+	zScore := 0.0
+	for _, m := range all {
+		if m.Name == "z" {
+			zScore = m.Score
+		}
+	}
+
+	d.SetMinConfidence(zScore + 0.01)
+	filtered := d.Detect([]byte("aaaaaaaaaa"))
+	for _, m := range filtered {
+		if m.Name == "z" {
+			t.Fatalf("expected %q to be filtered out below the confidence threshold, got match %+v", "z", m)
+		}
+	}
+}
+
+func TestDetectorBestMatch(t *testing.T) {
+	a := NewAnalyzer()
+	a.Feed([]byte("aaaaaaaaaa"))
+
+	z := NewAnalyzer()
+	z.Feed([]byte("zzzzzzzzzz"))
+
+	d := NewDetector()
+	d.Register("a", a)
+	d.Register("z", z)
+
+	best, ok := d.BestMatch([]byte("aaaaaaaaaa"))
+	if !ok {
+		t.Fatal("expected a best match")
+	}
+	if best.Name != "a" {
+		t.Fatalf("expected %q to be the best match, got %q", "a", best.Name)
+	}
+
+	empty := NewDetector()
+	if _, ok := empty.BestMatch([]byte("aaaaaaaaaa")); ok {
+		t.Fatal("expected no best match from an empty detector")
+	}
+}
+
+func TestDefaultDetectorBestMatchRealLanguageSamples(t *testing.T) {
+	samples := map[string]string{
+		"french":  "Chaque matin, je me promène dans le jardin pour admirer les fleurs et écouter le chant des oiseaux.",
+		"german":  "Die Kinder spielten fröhlich im Garten, während ihre Eltern gemütlich auf der Terrasse saßen und Kaffee tranken.",
+		"spanish": "Durante las vacaciones de verano, muchos niños pequeños disfrutan nadando en la piscina y jugando con sus amigos en el patio.",
+	}
+
+	for language, sample := range samples {
+		best, ok := DefaultDetector.BestMatch([]byte(sample))
+		if !ok {
+			t.Fatalf("expected a best match for %q sample, got none", language)
+		}
+		if best.Name != language {
+			t.Fatalf("expected %q sample to match %q, got %q (score %v)", language, language, best.Name, best.Score)
+		}
+	}
+}
+
+func TestDetectorRegistersNonAnalyzerScorers(t *testing.T) {
+	d := NewDetector()
+
+	d.Register("compression", NewCompressionScorer([]byte("the quick brown fox jumps over the lazy dog")))
+
+	ra := NewRuneAnalyzer(Bigram)
+	ra.FeedString("the quick brown fox jumps over the lazy dog")
+	d.Register("rune", ScorerFunc(func(contents []byte) float64 {
+		return ra.Score(contents, CosineSimilarity)
+	}))
+
+	matches := d.Detect([]byte("the quick brown fox"))
+	if len(matches) != 2 {
+		t.Fatalf("expected both a CompressionScorer and a wrapped RuneAnalyzer to register, got %d matches", len(matches))
+	}
+}
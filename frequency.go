@@ -1,7 +1,12 @@
 package frequency
 
 import (
+	"bytes"
 	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"math"
 	"os"
 	"sync"
@@ -17,25 +22,81 @@ type Analyzer struct {
 	mu        sync.RWMutex
 	frequency [256]int64
 	size      int64
+	language  string
 }
 
 func NewAnalyzer() *Analyzer {
 	return &Analyzer{}
 }
 
+// Language - the language tag associated with this analyzer, if any was set via SetLanguage or decoded from a
+// serialized profile.
+func (a *Analyzer) Language() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.language
+}
+
+// SetLanguage - tag this analyzer with a language identifier (e.g. a BCP 47 tag such as "en"), carried
+// alongside its profile by Save, MarshalJSON and MarshalBinary.
+func (a *Analyzer) SetLanguage(tag string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.language = tag
+}
+
 // Feed - Feed an analyzer with contents, updating the frequency table.
 // The analyzer state is updated - not replaced, so multiple Feed calls are OK.
 func (a *Analyzer) Feed(contents []byte) {
+	a.Write(contents)
+}
+
+// Write - implements io.Writer, updating the frequency table with p.  Always returns len(p), nil, so an
+// Analyzer can be used as the destination of io.Copy without buffering the whole input in memory first, e.g.
+// io.Copy(analyzer, resp.Body).
+func (a *Analyzer) Write(p []byte) (int, error) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	// Update the character count in analyzer
-	for _, character := range contents {
+	for _, character := range p {
 		a.frequency[character] += 1
 		a.size += 1
 	}
 
-	return
+	return len(p), nil
+}
+
+// ReadFrom - implements io.ReaderFrom, streaming r into the frequency table in fixed-size chunks rather than
+// reading it into memory whole.
+func (a *Analyzer) ReadFrom(r io.Reader) (int64, error) {
+	buf := make([]byte, 32*1024)
+	var total int64
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			a.Write(buf[:n])
+			total += int64(n)
+		}
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// Reset - zero the frequency table and size under the lock, so a single Analyzer can be reused across scoring
+// rounds.
+func (a *Analyzer) Reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.frequency = [256]int64{}
+	a.size = 0
 }
 
 // Score - Score contents according to the analyzer frequency tables.  Return a value in the range of 0 - 1.
@@ -54,44 +115,138 @@ func (a *Analyzer) ScoreString(text string) float64 {
 	return a.Score([]byte(text))
 }
 
-// Save - save the analyzer state to a file at path.
+// Save - save the analyzer state to a file at path, in the versioned binary format written by MarshalBinary.
 func (a *Analyzer) Save(path string) error {
-	file, err := os.Create(path)
+	data, err := a.MarshalBinary()
 	if err != nil {
 		return err
 	}
-	defer file.Close()
 
-	a.mu.RLock()
-	defer a.mu.RUnlock()
-	encoder := gob.NewEncoder(file)
-	if err := encoder.Encode(a.frequency); err != nil {
+	return os.WriteFile(path, data, 0644)
+}
+
+// Restore - restore the state previously saved at path, overwriting current analyzer state.  Restore also
+// recognizes the legacy format this package used before MarshalBinary existed - a bare gob.Encode of the
+// frequency table, with size reconstructed by summing - so profiles saved by older versions still load.
+func (a *Analyzer) Restore(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	err = a.UnmarshalBinary(data)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, errNotFreqFormat) {
+		return err
+	}
+
+	var frequency [256]int64
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&frequency); err != nil {
 		return err
 	}
 
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.frequency = frequency
+	a.size = 0
+	for _, v := range frequency {
+		a.size += v
+	}
+
 	return nil
 }
 
-// Restore - restore the state previously saved at path, overwriting current analyzer state
-func (a *Analyzer) Restore(path string) error {
-	file, err := os.Open(path)
+// binaryPayload is the gob-encoded portion of Analyzer's binary format, written after the shared header.
+type binaryPayload struct {
+	Size      int64
+	Frequency [256]int64
+}
+
+// MarshalBinary - encode the analyzer state as the package's versioned binary format: a header (magic bytes,
+// format version, entry width, optional language tag) followed by the frequency payload.
+func (a *Analyzer) MarshalBinary() ([]byte, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	var buf bytes.Buffer
+	h := header{version: formatVersion, kind: kindByte, language: a.language}
+	if err := writeHeader(&buf, h); err != nil {
+		return nil, err
+	}
+	if err := gob.NewEncoder(&buf).Encode(binaryPayload{Size: a.size, Frequency: a.frequency}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary - decode data previously produced by MarshalBinary, overwriting current analyzer state.
+// Returns an error wrapping errNotFreqFormat if data does not start with the expected header, so callers can
+// fall back to decoding a legacy format.
+func (a *Analyzer) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+
+	h, err := readHeader(r)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
+	if h.kind != kindByte {
+		return fmt.Errorf("frequency: expected a %s profile, got %s", kindByte, h.kind)
+	}
+
+	var payload binaryPayload
+	if err := gob.NewDecoder(r).Decode(&payload); err != nil {
+		return err
+	}
 
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	decoder := gob.NewDecoder(file)
-	if err := decoder.Decode(&a.frequency); err != nil {
+	a.size = payload.Size
+	a.frequency = payload.Frequency
+	a.language = h.language
+
+	return nil
+}
+
+// MarshalJSON - encode the analyzer state as a self-describing JSON profile, so it can be embedded in configs,
+// diffed in review, and shared with non-Go readers.
+func (a *Analyzer) MarshalJSON() ([]byte, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return json.Marshal(profile{
+		Version:  formatVersion,
+		Kind:     kindByte.String(),
+		Language: a.language,
+		Size:     a.size,
+		Byte:     a.frequency[:],
+	})
+}
+
+// UnmarshalJSON - decode a JSON profile previously produced by MarshalJSON, overwriting current analyzer state.
+func (a *Analyzer) UnmarshalJSON(data []byte) error {
+	var p profile
+	if err := json.Unmarshal(data, &p); err != nil {
 		return err
 	}
-
-	for _, v := range a.frequency {
-		a.size += v
+	if p.Kind != kindByte.String() {
+		return fmt.Errorf("frequency: expected a %s profile, got %q", kindByte, p.Kind)
+	}
+	if len(p.Byte) != 256 {
+		return fmt.Errorf("frequency: byte profile must have 256 entries, got %d", len(p.Byte))
 	}
 
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.size = p.Size
+	a.language = p.Language
+	copy(a.frequency[:], p.Byte)
+
 	return nil
 }
 
@@ -103,19 +258,6 @@ func relativeDifference(a, b float64) float64 {
 	return math.Abs(a-b) / max(a, b)
 }
 
-func scoreFrequencies(ref, target *Analyzer) (score float64) {
-	var r float64 = 0
-	var t float64 = 0
-
-	for i := 0; i < 256; i++ {
-		r = float64(ref.frequency[i]) / float64(ref.size)
-		t = float64(target.frequency[i]) / float64(target.size)
-		score += (r * (1 - relativeDifference(r, t)))
-	}
-
-	return score
-}
-
 func max(a, b float64) float64 {
 	if a > b {
 		return a
@@ -123,3 +265,37 @@ func max(a, b float64) float64 {
 		return b
 	}
 }
+
+// letterBytes is the set of frequency table indices scoreFrequencies compares: the ASCII letters, upper and
+// lower case. Whitespace and punctuation are excluded - their share is dominated by a handful of near-universal
+// bytes (space, comma, period) that every Latin-script profile carries in roughly the same proportion, so
+// including them swamps the letter-frequency signal that actually distinguishes one language's profile from
+// another's.
+var letterBytes = []int{
+	'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm',
+	'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z',
+	'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M',
+	'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z',
+}
+
+// scoreFrequencies - the cosine similarity between ref and target's frequency tables, restricted to the letter
+// bytes (see letterBytes) and compared as count vectors. Unlike a reference-weighted measure, this is symmetric
+// and unaffected by the two profiles' relative sizes, so a small or large reference corpus scores a match the
+// same way. See RuneAnalyzer's cosineSimilarity for the same measure applied to n-gram counts.
+func scoreFrequencies(ref, target *Analyzer) float64 {
+	var dot, refNorm, targetNorm float64
+
+	for _, i := range letterBytes {
+		r := float64(ref.frequency[i])
+		t := float64(target.frequency[i])
+		dot += r * t
+		refNorm += r * r
+		targetNorm += t * t
+	}
+
+	if refNorm == 0 || targetNorm == 0 {
+		return 0
+	}
+
+	return dot / (math.Sqrt(refNorm) * math.Sqrt(targetNorm))
+}
@@ -0,0 +1,78 @@
+package frequency
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestAnalyzerWriteMatchesFeed(t *testing.T) {
+	fed := NewAnalyzer()
+	fed.Feed([]byte("the quick brown fox"))
+
+	written := NewAnalyzer()
+	n, err := written.Write([]byte("the quick brown fox"))
+	if err != nil {
+		t.Fatalf("unexpected error from Write: %v", err)
+	}
+	if n != len("the quick brown fox") {
+		t.Fatalf("expected Write to report len(p), got %d", n)
+	}
+
+	if fed.frequency != written.frequency || fed.size != written.size {
+		t.Fatal("expected Write to update state identically to Feed")
+	}
+}
+
+func TestAnalyzerReadFromMatchesFeed(t *testing.T) {
+	fed := NewAnalyzer()
+	fed.Feed([]byte("the quick brown fox jumps over the lazy dog"))
+
+	copied := NewAnalyzer()
+	// Wrap the reader so io.Copy can't use bytes.Reader's WriteTo and has to go through Analyzer.ReadFrom.
+	n, err := io.Copy(copied, struct{ io.Reader }{bytes.NewReader([]byte("the quick brown fox jumps over the lazy dog"))})
+	if err != nil {
+		t.Fatalf("unexpected error from io.Copy: %v", err)
+	}
+	if n != int64(len("the quick brown fox jumps over the lazy dog")) {
+		t.Fatalf("expected io.Copy to report the full byte count, got %d", n)
+	}
+
+	if fed.frequency != copied.frequency || fed.size != copied.size {
+		t.Fatal("expected io.Copy via ReadFrom to update state identically to Feed")
+	}
+}
+
+func TestAnalyzerScoreIgnoresSharedPunctuation(t *testing.T) {
+	ref := NewAnalyzer()
+	ref.Feed([]byte("eeeeeeeeee"))
+
+	sameScore := ref.Score([]byte("eeeeeeeeee,,,,,,,,,,,,,,,,,,,,"))
+	differentScore := ref.Score([]byte("zzzzzzzzzz,,,,,,,,,,,,,,,,,,,,"))
+
+	if sameScore <= differentScore {
+		t.Fatalf("expected matching letters to score higher than mismatched letters despite identical punctuation: same=%v different=%v", sameScore, differentScore)
+	}
+}
+
+func TestAnalyzerReset(t *testing.T) {
+	a := NewAnalyzer()
+	a.Feed([]byte("the quick brown fox"))
+
+	a.Reset()
+
+	if a.size != 0 {
+		t.Fatalf("expected size 0 after Reset, got %d", a.size)
+	}
+	for i, count := range a.frequency {
+		if count != 0 {
+			t.Fatalf("expected frequency[%d] to be 0 after Reset, got %d", i, count)
+		}
+	}
+
+	// A reset analyzer should be reusable for a fresh scoring round.
+	a.Feed([]byte("aaaaa"))
+	if a.size != 5 {
+		t.Fatalf("expected size 5 after feeding a reset analyzer, got %d", a.size)
+	}
+}
@@ -0,0 +1,42 @@
+package frequency
+
+// The tables below are derived from published per-language letter-frequency statistics (the kind commonly
+// tabulated for cryptanalysis and NLP, e.g. the frequency lists collected on Wikipedia's "Letter frequency"
+// page), scaled to a nominal corpus size. Letter shares differ per language; punctuation and whitespace
+// shares are approximated from general Latin-script prose and are not independently sourced per language.
+
+var FrenchAnalyzer = &Analyzer{
+	// Letter frequencies for French.
+	frequency: [256]int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6244, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 208122, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 12487, 0, 10406, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5345, 631, 2282, 2568, 10301, 746, 606, 516, 5270, 429, 52, 3819, 2078, 4967, 4057, 1765, 953, 4685, 5564, 5071, 4418, 1287, 52, 299, 90, 228, 0, 0, 0, 0, 0, 0, 76360, 9010, 32600, 36690, 147150, 10660, 8660, 7370, 75290, 6130, 740, 54560, 29680, 70950, 57960, 25210, 13620, 66930, 79480, 72440, 63110, 18380, 740, 4270, 1280, 3260, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+	size:      1277868,
+}
+
+var GermanAnalyzer = &Analyzer{
+	// Letter frequencies for German.
+	frequency: [256]int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6271, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 209033, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 12542, 0, 10452, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4561, 1320, 1912, 3553, 11477, 1159, 2106, 3204, 4585, 188, 992, 2406, 1774, 6843, 1816, 469, 13, 4902, 5089, 4308, 2916, 592, 1345, 24, 27, 794, 0, 0, 0, 0, 0, 0, 65160, 18860, 27320, 50760, 163960, 16560, 30090, 45770, 65500, 2680, 14170, 34370, 25340, 97760, 25940, 6700, 180, 70030, 72700, 61540, 41660, 8460, 19210, 340, 390, 11340, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+	size:      1283463,
+}
+
+var SpanishAnalyzer = &Analyzer{
+	// Letter frequencies for Spanish.
+	frequency: [256]int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6229, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 207628, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 12458, 0, 10381, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8068, 1551, 2813, 3507, 8527, 484, 1238, 492, 4373, 345, 8, 3477, 2210, 4698, 6078, 1757, 614, 4810, 5584, 3242, 2049, 797, 12, 151, 706, 327, 0, 0, 0, 0, 0, 0, 115250, 22150, 40190, 50100, 121810, 6920, 17680, 7030, 62470, 4930, 110, 49670, 31570, 67120, 86830, 25100, 8770, 68710, 79770, 46320, 29270, 11380, 170, 2150, 10080, 4670, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+	size:      1274834,
+}
+
+var ItalianAnalyzer = &Analyzer{
+	// Letter frequencies for Italian.
+	frequency: [256]int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6408, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 213606, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 12816, 0, 10680, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8222, 649, 3151, 2615, 8254, 807, 1151, 1079, 7100, 8, 6, 4557, 1758, 4818, 6882, 2139, 354, 4457, 3487, 3936, 2108, 1468, 23, 2, 14, 827, 0, 0, 0, 0, 0, 0, 117450, 9270, 45010, 37360, 117920, 11530, 16440, 15410, 101430, 110, 90, 65100, 25120, 68830, 98320, 30560, 5050, 63670, 49810, 56230, 30110, 20970, 330, 30, 200, 11810, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+	size:      1311542,
+}
+
+var PortugueseAnalyzer = &Analyzer{
+	// Letter frequencies for Portuguese.
+	frequency: [256]int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6157, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 205228, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 12314, 0, 10261, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10244, 730, 2717, 3494, 8799, 716, 912, 547, 4330, 278, 11, 1945, 3317, 3112, 6814, 1766, 843, 4571, 4764, 3035, 2547, 1102, 26, 177, 4, 329, 0, 0, 0, 0, 0, 0, 146340, 10430, 38820, 49920, 125700, 10230, 13030, 7810, 61860, 3970, 150, 27790, 47380, 44460, 97340, 25230, 12040, 65300, 68050, 43360, 36390, 15750, 370, 2530, 60, 4700, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+	size:      1260100,
+}
+
+var DutchAnalyzer = &Analyzer{
+	// Letter frequencies for Dutch.
+	frequency: [256]int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6430, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 214336, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 12860, 0, 10717, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5240, 1109, 869, 4153, 13237, 564, 2382, 1666, 4549, 1022, 1574, 2498, 1549, 7022, 4244, 1099, 6, 4488, 2611, 4753, 1393, 1995, 1064, 25, 25, 973, 0, 0, 0, 0, 0, 0, 74860, 15840, 12420, 59330, 189100, 8050, 34030, 23800, 64990, 14600, 22480, 35680, 22130, 100320, 60630, 15700, 90, 64110, 37300, 67900, 19900, 28500, 15200, 360, 350, 13900, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+	size:      1316023,
+}
@@ -0,0 +1,119 @@
+package frequency
+
+import (
+	"math"
+	"testing"
+)
+
+func englishLikeRuneAnalyzer() *RuneAnalyzer {
+	a := NewRuneAnalyzer(Trigram)
+	a.FeedString("the quick brown fox jumps over the lazy dog while the cat watches the dog run")
+	return a
+}
+
+func spanishLikeRuneAnalyzer() *RuneAnalyzer {
+	a := NewRuneAnalyzer(Trigram)
+	a.FeedString("el rápido zorro marrón salta sobre el perro perezoso mientras el gato observa al perro")
+	return a
+}
+
+func TestRuneAnalyzerCosineSimilarityDiscriminatesProfiles(t *testing.T) {
+	english := englishLikeRuneAnalyzer()
+	spanish := spanishLikeRuneAnalyzer()
+
+	sameFamily := english.ScoreString("the quick fox runs over the dog", CosineSimilarity)
+	crossFamily := english.ScoreString("el perro corre sobre el gato", CosineSimilarity)
+
+	if sameFamily <= crossFamily {
+		t.Fatalf("expected English text to score higher against an English profile than Spanish text does: same=%v cross=%v", sameFamily, crossFamily)
+	}
+	_ = spanish
+}
+
+func TestRuneAnalyzerRankDistanceDiscriminatesProfiles(t *testing.T) {
+	english := englishLikeRuneAnalyzer()
+
+	sameFamily := english.ScoreString("the quick fox runs over the dog", RankDistance)
+	crossFamily := english.ScoreString("el perro corre sobre el gato", RankDistance)
+
+	if sameFamily <= crossFamily {
+		t.Fatalf("expected English text to score higher against an English profile than Spanish text does: same=%v cross=%v", sameFamily, crossFamily)
+	}
+}
+
+func TestRuneAnalyzerRelativeFrequencyDefaultMethod(t *testing.T) {
+	a := englishLikeRuneAnalyzer()
+
+	withMethod := a.ScoreString("the quick fox runs over the dog", RelativeFrequency)
+	withoutMethod := a.ScoreString("the quick fox runs over the dog")
+
+	const epsilon = 1e-9
+	if diff := withMethod - withoutMethod; diff > epsilon || diff < -epsilon {
+		t.Fatalf("expected omitting ScoreMethod to default to RelativeFrequency: %v != %v", withMethod, withoutMethod)
+	}
+}
+
+func TestRuneAnalyzerRelativeFrequencyHandlesShortInputWithoutNaN(t *testing.T) {
+	a := englishLikeRuneAnalyzer()
+
+	score := a.ScoreString("x", RelativeFrequency)
+	if math.IsNaN(score) {
+		t.Fatal("expected scoring contents shorter than the n-gram order not to produce NaN")
+	}
+
+	empty := NewRuneAnalyzer(Bigram)
+	score = empty.ScoreString("the quick fox runs over the dog", RelativeFrequency)
+	if math.IsNaN(score) {
+		t.Fatal("expected scoring against an unfed reference not to produce NaN")
+	}
+}
+
+func TestRuneAnalyzerPruneDropsLowCountNgrams(t *testing.T) {
+	a := NewRuneAnalyzer(Unigram)
+	a.FeedString("aaaaabbbbbc")
+
+	sizeBefore := a.size
+	a.Prune(2)
+
+	if _, ok := a.counts["c"]; ok {
+		t.Fatal("expected the single-occurrence n-gram \"c\" to be pruned")
+	}
+	if _, ok := a.counts["a"]; !ok {
+		t.Fatal("expected the frequent n-gram \"a\" to survive pruning")
+	}
+	if a.size != sizeBefore-1 {
+		t.Fatalf("expected size to drop by the pruned count only: before=%d after=%d", sizeBefore, a.size)
+	}
+}
+
+func TestRuneAnalyzerUnmarshalJSONEmptyProfileFeedsWithoutPanic(t *testing.T) {
+	empty := NewRuneAnalyzer(Bigram)
+
+	data, err := empty.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	restored := &RuneAnalyzer{}
+	if err := restored.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+
+	restored.FeedString("ab")
+
+	if restored.counts["ab"] != 1 {
+		t.Fatalf("expected \"ab\" to be counted once after feeding an empty, round-tripped profile, got %+v", restored.counts)
+	}
+}
+
+func TestRuneAnalyzerNgramOrder(t *testing.T) {
+	a := NewRuneAnalyzer(Bigram)
+	a.FeedString("abc")
+
+	if a.counts["ab"] != 1 || a.counts["bc"] != 1 {
+		t.Fatalf("expected bigrams \"ab\" and \"bc\", got %+v", a.counts)
+	}
+	if a.size != 2 {
+		t.Fatalf("expected size 2 for a 3-rune input under Bigram, got %d", a.size)
+	}
+}
@@ -0,0 +1,107 @@
+package frequency
+
+import (
+	"errors"
+	"io"
+)
+
+// magicBytes marks the start of this package's self-describing binary format, so Restore can tell it apart
+// from the legacy raw gob.Encode(a.frequency) blob that Analyzer used to write directly.
+var magicBytes = [4]byte{'F', 'R', 'Q', '1'}
+
+const formatVersion = 1
+
+// errNotFreqFormat is returned by readHeader when data does not start with magicBytes, so callers can fall
+// back to decoding a legacy format instead.
+var errNotFreqFormat = errors.New("frequency: not a recognized binary profile")
+
+// entryKind records whether a serialized profile's payload is Analyzer's fixed byte histogram or
+// RuneAnalyzer's n-gram map, so a reader can tell the two apart before decoding the payload.
+type entryKind byte
+
+const (
+	kindByte entryKind = iota
+	kindRuneNgram
+)
+
+func (k entryKind) String() string {
+	if k == kindRuneNgram {
+		return "rune-ngram"
+	}
+	return "byte"
+}
+
+// profile is the self-describing representation shared by Analyzer and RuneAnalyzer's JSON encoding: a header
+// (kind, version, optional language tag) followed by the frequency payload.
+type profile struct {
+	Version  int              `json:"version"`
+	Kind     string           `json:"kind"`
+	Language string           `json:"language,omitempty"`
+	Order    int              `json:"order,omitempty"`
+	Size     int64            `json:"size"`
+	Byte     []int64          `json:"byte,omitempty"`
+	Ngram    map[string]int64 `json:"ngram,omitempty"`
+}
+
+// header is the binary encoding's preamble: magic bytes, format version, entry width (kindByte vs
+// kindRuneNgram), n-gram order (0 for kindByte), and a length-prefixed language tag.
+type header struct {
+	version  int
+	kind     entryKind
+	order    int
+	language string
+}
+
+func writeHeader(w io.Writer, h header) error {
+	if _, err := w.Write(magicBytes[:]); err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte{byte(h.version), byte(h.kind), byte(h.order)}); err != nil {
+		return err
+	}
+	if len(h.language) > 255 {
+		return errors.New("frequency: language tag too long")
+	}
+	if _, err := w.Write([]byte{byte(len(h.language))}); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, h.language); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func readHeader(r io.Reader) (header, error) {
+	var h header
+
+	var magic [4]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return h, err
+	}
+	if magic != magicBytes {
+		return h, errNotFreqFormat
+	}
+
+	var fixed [3]byte
+	if _, err := io.ReadFull(r, fixed[:]); err != nil {
+		return h, err
+	}
+	h.version = int(fixed[0])
+	h.kind = entryKind(fixed[1])
+	h.order = int(fixed[2])
+
+	var langLen [1]byte
+	if _, err := io.ReadFull(r, langLen[:]); err != nil {
+		return h, err
+	}
+	if langLen[0] > 0 {
+		lang := make([]byte, langLen[0])
+		if _, err := io.ReadFull(r, lang); err != nil {
+			return h, err
+		}
+		h.language = string(lang)
+	}
+
+	return h, nil
+}
@@ -0,0 +1,247 @@
+package frequency
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAnalyzerMarshalBinaryRoundTrip(t *testing.T) {
+	a := NewAnalyzer()
+	a.SetLanguage("en")
+	a.Feed([]byte("the quick brown fox"))
+
+	data, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	restored := NewAnalyzer()
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+
+	if restored.frequency != a.frequency || restored.size != a.size {
+		t.Fatal("expected UnmarshalBinary to reproduce the original frequency table and size")
+	}
+	if restored.Language() != "en" {
+		t.Fatalf("expected language tag %q to round-trip, got %q", "en", restored.Language())
+	}
+}
+
+func TestAnalyzerMarshalJSONRoundTrip(t *testing.T) {
+	a := NewAnalyzer()
+	a.SetLanguage("en")
+	a.Feed([]byte("the quick brown fox"))
+
+	data, err := json.Marshal(a)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+
+	restored := NewAnalyzer()
+	if err := json.Unmarshal(data, restored); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	if restored.frequency != a.frequency || restored.size != a.size {
+		t.Fatal("expected JSON round trip to reproduce the original frequency table and size")
+	}
+	if restored.Language() != "en" {
+		t.Fatalf("expected language tag %q to round-trip, got %q", "en", restored.Language())
+	}
+}
+
+func TestAnalyzerSaveRestoreRoundTrip(t *testing.T) {
+	a := NewAnalyzer()
+	a.SetLanguage("en")
+	a.Feed([]byte("the quick brown fox"))
+
+	path := filepath.Join(t.TempDir(), "analyzer.bin")
+	if err := a.Save(path); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	restored := NewAnalyzer()
+	if err := restored.Restore(path); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	if restored.frequency != a.frequency || restored.size != a.size {
+		t.Fatal("expected Restore to reproduce the original frequency table and size")
+	}
+}
+
+func TestAnalyzerRestoreFallsBackToLegacyGobFormat(t *testing.T) {
+	var legacy [256]int64
+	legacy['a'] = 5
+	legacy['b'] = 2
+
+	path := filepath.Join(t.TempDir(), "legacy.bin")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("os.Create: %v", err)
+	}
+	if err := gob.NewEncoder(file).Encode(legacy); err != nil {
+		t.Fatalf("gob.Encode: %v", err)
+	}
+	file.Close()
+
+	restored := NewAnalyzer()
+	if err := restored.Restore(path); err != nil {
+		t.Fatalf("expected Restore to fall back to the legacy gob format, got error: %v", err)
+	}
+
+	if restored.frequency != legacy {
+		t.Fatalf("expected legacy frequency table to round-trip, got %v", restored.frequency)
+	}
+	if restored.size != 7 {
+		t.Fatalf("expected size to be reconstructed by summing the legacy table, got %d", restored.size)
+	}
+}
+
+func TestAnalyzerUnmarshalBinaryRejectsRuneAnalyzerKind(t *testing.T) {
+	ra := NewRuneAnalyzer(Bigram)
+	ra.FeedString("hola que tal")
+
+	data, err := ra.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	a := NewAnalyzer()
+	if err := a.UnmarshalBinary(data); err == nil {
+		t.Fatal("expected UnmarshalBinary to reject a rune-ngram profile")
+	}
+}
+
+func TestAnalyzerUnmarshalJSONRejectsRuneAnalyzerKind(t *testing.T) {
+	ra := NewRuneAnalyzer(Bigram)
+	ra.FeedString("hola que tal")
+
+	data, err := json.Marshal(ra)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+
+	a := NewAnalyzer()
+	if err := json.Unmarshal(data, a); err == nil {
+		t.Fatal("expected UnmarshalJSON to reject a rune-ngram profile")
+	}
+}
+
+func TestRuneAnalyzerMarshalBinaryRoundTrip(t *testing.T) {
+	ra := NewRuneAnalyzer(Trigram)
+	ra.SetLanguage("es")
+	ra.FeedString("hola que tal amigo")
+
+	data, err := ra.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	restored := NewRuneAnalyzer(Trigram)
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+
+	if restored.size != ra.size {
+		t.Fatalf("expected size to round-trip: want %d, got %d", ra.size, restored.size)
+	}
+	if restored.order != ra.order {
+		t.Fatalf("expected n-gram order to round-trip: want %d, got %d", ra.order, restored.order)
+	}
+	if restored.Language() != "es" {
+		t.Fatalf("expected language tag %q to round-trip, got %q", "es", restored.Language())
+	}
+	for gram, count := range ra.counts {
+		if restored.counts[gram] != count {
+			t.Fatalf("expected n-gram %q count %d to round-trip, got %d", gram, count, restored.counts[gram])
+		}
+	}
+}
+
+func TestRuneAnalyzerMarshalJSONRoundTrip(t *testing.T) {
+	ra := NewRuneAnalyzer(Bigram)
+	ra.SetLanguage("es")
+	ra.FeedString("hola que tal")
+
+	data, err := json.Marshal(ra)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+
+	restored := NewRuneAnalyzer(Bigram)
+	if err := json.Unmarshal(data, restored); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	if restored.size != ra.size || restored.order != ra.order {
+		t.Fatalf("expected size and order to round-trip: want (%d,%d), got (%d,%d)", ra.size, ra.order, restored.size, restored.order)
+	}
+	for gram, count := range ra.counts {
+		if restored.counts[gram] != count {
+			t.Fatalf("expected n-gram %q count %d to round-trip, got %d", gram, count, restored.counts[gram])
+		}
+	}
+}
+
+func TestRuneAnalyzerSaveRestoreRoundTrip(t *testing.T) {
+	ra := NewRuneAnalyzer(Bigram)
+	ra.FeedString("hola que tal")
+
+	path := filepath.Join(t.TempDir(), "rune.bin")
+	if err := ra.Save(path); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	restored := NewRuneAnalyzer(Bigram)
+	if err := restored.Restore(path); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	if restored.size != ra.size {
+		t.Fatalf("expected size to round-trip: want %d, got %d", ra.size, restored.size)
+	}
+}
+
+func TestRuneAnalyzerUnmarshalBinaryRejectsAnalyzerKind(t *testing.T) {
+	a := NewAnalyzer()
+	a.Feed([]byte("the quick brown fox"))
+
+	data, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	ra := NewRuneAnalyzer(Bigram)
+	if err := ra.UnmarshalBinary(data); err == nil {
+		t.Fatal("expected UnmarshalBinary to reject a byte-histogram profile")
+	}
+}
+
+func TestRuneAnalyzerUnmarshalJSONRejectsAnalyzerKind(t *testing.T) {
+	a := NewAnalyzer()
+	a.Feed([]byte("the quick brown fox"))
+
+	data, err := json.Marshal(a)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+
+	ra := NewRuneAnalyzer(Bigram)
+	if err := json.Unmarshal(data, ra); err == nil {
+		t.Fatal("expected UnmarshalJSON to reject a byte-histogram profile")
+	}
+}
+
+func TestReadHeaderRejectsDataWithoutMagicBytes(t *testing.T) {
+	_, err := readHeader(bytes.NewReader([]byte("not a frequency profile")))
+	if !errors.Is(err, errNotFreqFormat) {
+		t.Fatalf("expected errNotFreqFormat, got %v", err)
+	}
+}